@@ -0,0 +1,113 @@
+package exported
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClientType defines the type of the consensus algorithm
+type ClientType byte
+
+// available client types
+const (
+	Tendermint ClientType = iota
+)
+
+// string representations of the client types
+const (
+	ClientTypeTendermint string = "tendermint"
+)
+
+// String implements the Stringer interface
+func (ct ClientType) String() string {
+	switch ct {
+	case Tendermint:
+		return ClientTypeTendermint
+	default:
+		return ""
+	}
+}
+
+// Height is the type used to identify the consensus state a client stores
+// a given update at.
+type Height uint64
+
+// ConsensusState is the state of the consensus process
+type ConsensusState interface {
+	ClientType() ClientType
+	GetRoot() []byte
+	GetTimestamp() uint64
+	ValidateBasic() error
+}
+
+// ClientMessage is the interface used to update an IBC client. A
+// ClientMessage is either a Header, which advances the client to a new
+// height, or a Misbehaviour, which proves a violation of the light client
+// protocol and freezes the client. Submitting either kind goes through the
+// same Keeper.UpdateClient entry point. ClientMessage carries no height of
+// its own: the heights a client was actually updated to are reported back
+// by ClientState.UpdateState, since a single message can advance a client
+// to more than one consensus height (e.g. a batched header submission).
+type ClientMessage interface {
+	ClientType() ClientType
+	ValidateBasic() error
+}
+
+// Header is the consensus state update injected into a client by way of
+// MsgUpdateClient. Headers are implementation specific and may vary across
+// client types.
+type Header interface {
+	ClientMessage
+}
+
+// Misbehaviour defines the evidence that a client can be submitted as proof
+// of a violation of the light client protocol by its counterparty.
+//
+// Deprecated: submit misbehaviour through Keeper.UpdateClient as a
+// ClientMessage instead of through the dedicated
+// Keeper.CheckMisbehaviourAndUpdateState entry point.
+type Misbehaviour interface {
+	ClientMessage
+	GetClientID() string
+}
+
+// ClientState is the implementation specific state of a light client,
+// tracking the status and consensus state history of a counterparty chain.
+type ClientState interface {
+	ClientType() ClientType
+	GetID() string
+	GetLatestHeight() Height
+	IsFrozen() bool
+	Validate() error
+
+	// Initialize is called upon client creation, it allows the client to
+	// perform any additional setup required to validate and store an initial
+	// consensus state.
+	Initialize(ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, consState ConsensusState) error
+
+	// VerifyClientMessage verifies a client message, which can be a Header or
+	// a Misbehaviour, against the consensus state(s) the client already
+	// trusts. It does not mutate the client store.
+	VerifyClientMessage(ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, clientMsg ClientMessage) error
+
+	// CheckForMisbehaviour checks whether applying the client message would
+	// conflict with previously verified commitments, e.g. two valid headers
+	// for the same height with different state roots, or a header that
+	// contradicts an already stored consensus state.
+	CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, clientMsg ClientMessage) bool
+
+	// UpdateStateOnMisbehaviour performs appropriate state changes for a
+	// client that has detected misbehaviour, namely freezing the client,
+	// and returns the updated ClientState. Implementations have a value
+	// receiver, so the caller MUST persist the returned ClientState via
+	// Keeper.SetClientState itself; saving the pre-call value is a no-op.
+	UpdateStateOnMisbehaviour(ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, clientMsg ClientMessage) ClientState
+
+	// UpdateState updates and stores as necessary any associated information
+	// for an IBC client, such as the consensus state and metadata. It
+	// assumes the client message has already been verified. It returns the
+	// updated ClientState together with the heights of the newly stored
+	// consensus states. As with UpdateStateOnMisbehaviour, the caller MUST
+	// persist the returned ClientState itself.
+	UpdateState(ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, clientMsg ClientMessage) (ClientState, []Height)
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// NewHandler creates an sdk.Handler for the IBC client submodule. It routes
+// every MsgUpdateClient to Keeper.UpdateClient regardless of whether the
+// ClientMessage it carries is a Header or a Misbehaviour, since the keeper
+// no longer needs to know which one it is handed.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgUpdateClient:
+			clientMsg, err := msg.GetClientMessage()
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "MsgUpdateClient")
+			}
+
+			if err := k.UpdateClient(ctx, msg.ClientID, clientMsg); err != nil {
+				return nil, sdkerrors.Wrap(err, "MsgUpdateClient")
+			}
+
+			return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized IBC client message type: %T", msg)
+		}
+	}
+}
@@ -1,21 +1,31 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
-	tendermint "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint"
 )
 
 // CreateClient creates a new client state and populates it with a given consensus
 // state as defined in https://github.com/cosmos/ics/tree/master/spec/ics-002-client-semantics#create
+//
+// clientState is the fully-constructed, type-specific ClientState for the
+// light client being created (e.g. a tendermint.ClientState carrying its
+// own chain-id, trusting period and unbonding period) — CreateClient itself
+// has no way to fill in those type-specific fields, so it does not attempt
+// to build the ClientState from just a clientID and ClientType as it did
+// previously. The router is only consulted to confirm a light-client module
+// is actually registered for clientState's type.
 func (k Keeper) CreateClient(
-	ctx sdk.Context, clientID string,
-	clientType exported.ClientType, consensusState exported.ConsensusState,
+	ctx sdk.Context, clientState exported.ClientState, consensusState exported.ConsensusState,
 ) (exported.ClientState, error) {
+	clientID := clientState.GetID()
+
 	_, found := k.GetClientState(ctx, clientID)
 	if found {
 		return nil, sdkerrors.Wrapf(types.ErrClientExists, "cannot create client with ID %s", clientID)
@@ -26,13 +36,21 @@ func (k Keeper) CreateClient(
 		panic(fmt.Sprintf("client type is already defined for client %s", clientID))
 	}
 
-	clientState, err := k.initialize(ctx, clientID, clientType, consensusState)
-	if err != nil {
+	if !k.router.HasRoute(clientState.ClientType()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidClientType, "no light client module registered for client type %s", clientState.ClientType())
+	}
+
+	if err := clientState.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(err, "cannot create client with ID %s", clientID)
+	}
+
+	clientStore := k.ClientStore(ctx, clientID)
+	if err := clientState.Initialize(ctx, k.cdc, clientStore, consensusState); err != nil {
 		return nil, sdkerrors.Wrapf(err, "cannot create client with ID %s", clientID)
 	}
 
 	k.SetClientState(ctx, clientState)
-	k.SetClientType(ctx, clientID, clientType)
+	k.SetClientType(ctx, clientID, clientState.ClientType())
 	k.Logger(ctx).Info(fmt.Sprintf("client %s created at height %d", clientID, clientState.GetLatestHeight()))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -49,15 +67,22 @@ func (k Keeper) CreateClient(
 	return clientState, nil
 }
 
-// UpdateClient updates the consensus state and the state root from a provided header
-func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.Header) error {
+// UpdateClient is the single entry point for both headers and misbehaviour:
+// it verifies the ClientMessage, checks whether it amounts to evidence of
+// misbehaviour and, if so, freezes the client instead of applying it. The
+// type switch previously hardcoded here has moved behind the
+// VerifyClientMessage/CheckForMisbehaviour/UpdateStateOnMisbehaviour/
+// UpdateState hooks on exported.ClientState, so a duplicate or conflicting
+// header, or a dedicated misbehaviour submission, are both normal outcomes
+// of this call rather than routed through separate message types.
+func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
 	clientType, found := k.GetClientType(ctx, clientID)
 	if !found {
 		return sdkerrors.Wrapf(types.ErrClientTypeNotFound, "cannot update client with ID %s", clientID)
 	}
 
-	// check that the header consensus matches the client one
-	if header.ClientType() != clientType {
+	// check that the client message consensus matches the client one
+	if clientMsg.ClientType() != clientType {
 		return sdkerrors.Wrapf(types.ErrInvalidConsensus, "cannot update client with ID %s", clientID)
 	}
 
@@ -71,31 +96,91 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 		return sdkerrors.Wrapf(types.ErrClientFrozen, "cannot update client with ID %s", clientID)
 	}
 
-	var (
-		consensusState exported.ConsensusState
-		err            error
-	)
+	clientStore := k.ClientStore(ctx, clientID)
 
-	switch clientType {
-	case exported.Tendermint:
-		clientState, consensusState, err = tendermint.CheckValidityAndUpdateState(clientState, header, ctx.ChainID())
-	default:
-		return sdkerrors.Wrapf(types.ErrInvalidClientType, "cannot update client with ID %s", clientID)
+	if err := clientState.VerifyClientMessage(ctx, k.cdc, clientStore, clientMsg); err != nil {
+		return sdkerrors.Wrapf(err, "cannot update client with ID %s", clientID)
 	}
 
-	if err != nil {
-		return sdkerrors.Wrapf(err, "cannot update client with ID %s", clientID)
+	// hex-encoding the raw ClientMessage bytes (rather than emitting them
+	// directly) keeps the event JSON-safe, since proto-marshaled bytes are
+	// not valid UTF-8.
+	headerHex := hex.EncodeToString(k.cdc.MustMarshalBinaryBare(clientMsg))
+
+	foundMisbehaviour := clientState.CheckForMisbehaviour(ctx, k.cdc, clientStore, clientMsg)
+	if foundMisbehaviour {
+		// UpdateStateOnMisbehaviour has a value receiver, so it cannot mutate
+		// clientState in place: it must be re-assigned from the returned
+		// ClientState before SetClientState persists it, or the freeze is
+		// silently dropped.
+		clientState = clientState.UpdateStateOnMisbehaviour(ctx, k.cdc, clientStore, clientMsg)
+		k.SetClientState(ctx, clientState)
+		k.Logger(ctx).Info(fmt.Sprintf("client %s frozen due to misbehaviour", clientID))
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSubmitMisbehaviour,
+				sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+				sdk.NewAttribute(types.AttributeKeyClientType, clientType.String()),
+				sdk.NewAttribute(types.AttributeKeyHeader, headerHex),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+		})
+
+		return nil
 	}
 
-	k.SetClientState(ctx, clientState)
-	k.SetClientConsensusState(ctx, clientID, header.GetHeight(), consensusState)
-	k.Logger(ctx).Info(fmt.Sprintf("client %s updated to height %d", clientID, header.GetHeight()))
+	// UpdateState persists every consensus state it produces directly to the
+	// client store and reports back the updated ClientState along with the
+	// heights it wrote them at, so a single ClientMessage can advance the
+	// client by more than one height (e.g. a batched header submission). A
+	// header that exactly duplicates an already-stored consensus state (a
+	// relayer race, not a conflict, since a genuine conflict would have been
+	// caught above as misbehaviour) produces no new heights here, and we
+	// skip the SetClientState write entirely so a resubmitted duplicate
+	// header doesn't pay for a no-op state write.
+	updatedClientState, consensusHeights := clientState.UpdateState(ctx, k.cdc, clientStore, clientMsg)
+
+	if len(consensusHeights) == 0 {
+		k.Logger(ctx).Info(fmt.Sprintf("client %s already updated, ignoring duplicate header", clientID))
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUpdateClient,
+				sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+				sdk.NewAttribute(types.AttributeKeyAlreadyUpdated, "true"),
+				sdk.NewAttribute(types.AttributeKeyHeader, headerHex),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+		})
+
+		return nil
+	}
+
+	k.SetClientState(ctx, updatedClientState)
+
+	heightStrs := make([]string, len(consensusHeights))
+	for i, height := range consensusHeights {
+		heightStrs[i] = fmt.Sprintf("%d", height)
+	}
+	k.Logger(ctx).Info(fmt.Sprintf("client %s updated to heights %s", clientID, strings.Join(heightStrs, ",")))
+
+	event := sdk.NewEvent(
+		types.EventTypeUpdateClient,
+		sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+		sdk.NewAttribute(types.AttributeKeyConsensusHeights, strings.Join(heightStrs, ",")),
+		sdk.NewAttribute(types.AttributeKeyConsensusHeight, heightStrs[0]),
+		sdk.NewAttribute(types.AttributeKeyHeader, headerHex),
+	)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeUpdateClient,
-			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
-		),
+		event,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
@@ -105,44 +190,12 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 	return nil
 }
 
-// CheckMisbehaviourAndUpdateState checks for client misbehaviour and freezes the
-// client if so.
+// CheckMisbehaviourAndUpdateState checks for client misbehaviour and freezes
+// the client if so.
+//
+// Deprecated: call UpdateClient directly with the Misbehaviour as its
+// ClientMessage argument. This wrapper is kept only so that callers built
+// against the old two-entry-point API keep working.
 func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour exported.Misbehaviour) error {
-	clientState, found := k.GetClientState(ctx, misbehaviour.GetClientID())
-	if !found {
-		return sdkerrors.Wrap(types.ErrClientNotFound, misbehaviour.GetClientID())
-	}
-
-	consensusState, found := k.GetClientConsensusState(ctx, misbehaviour.GetClientID(), uint64(misbehaviour.GetHeight()))
-	if !found {
-		return sdkerrors.Wrap(types.ErrConsensusStateNotFound, misbehaviour.GetClientID())
-	}
-
-	var err error
-	switch e := misbehaviour.(type) {
-	case tendermint.Evidence:
-		clientState, err = tendermint.CheckMisbehaviourAndUpdateState(
-			clientState, consensusState, misbehaviour, uint64(misbehaviour.GetHeight()),
-		)
-
-	default:
-		err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized IBC client evidence type: %T", e)
-	}
-
-	if err != nil {
-		return err
-	}
-
-	k.SetClientState(ctx, clientState)
-	k.Logger(ctx).Info(fmt.Sprintf("client %s frozen due to misbehaviour", misbehaviour.GetClientID()))
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeSubmitMisbehaviour,
-			sdk.NewAttribute(types.AttributeKeyClientID, misbehaviour.GetClientID()),
-			sdk.NewAttribute(types.AttributeKeyClientType, misbehaviour.ClientType().String()),
-		),
-	)
-
-	return nil
+	return k.UpdateClient(ctx, misbehaviour.GetClientID(), misbehaviour)
 }
\ No newline at end of file
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// Keeper represents a type that grants read and write permissions to any
+// client state information
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryMarshaler
+	router   *types.ClientRouter
+}
+
+// NewKeeper creates a new Keeper instance. The router must have every
+// light-client module it will need registered and sealed beforehand; the
+// keeper never mutates it after construction.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router *types.ClientRouter) Keeper {
+	if router == nil {
+		panic("ClientRouter must not be nil")
+	}
+
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/ibc/%s", types.SubModuleName))
+}
+
+// ClientStore returns an isolated prefix store for a single client so that
+// light-client modules can read and write their own state without being
+// able to read or write another client's data.
+func (k Keeper) ClientStore(ctx sdk.Context, clientID string) sdk.KVStore {
+	clientPrefix := []byte(fmt.Sprintf("clients/%s/", clientID))
+	return prefix.NewStore(ctx.KVStore(k.storeKey), clientPrefix)
+}
+
+// GetClientType gets the consensus type for a specific client
+func (k Keeper) GetClientType(ctx sdk.Context, clientID string) (exported.ClientType, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyClientType(clientID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return exported.ClientType(bz[0]), true
+}
+
+// SetClientType sets the client's consensus type in the store
+func (k Keeper) SetClientType(ctx sdk.Context, clientID string, clientType exported.ClientType) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyClientType(clientID), []byte{byte(clientType)})
+}
+
+// GetClientState gets a particular client from the store
+func (k Keeper) GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool) {
+	store := k.ClientStore(ctx, clientID)
+	bz := store.Get(types.KeyClientState())
+	if bz == nil {
+		return nil, false
+	}
+
+	var clientState exported.ClientState
+	k.cdc.MustUnmarshalBinaryBare(bz, &clientState)
+	return clientState, true
+}
+
+// SetClientState writes a client's state to its own prefix store, keyed off
+// the identifier the ClientState itself was created with
+func (k Keeper) SetClientState(ctx sdk.Context, clientState exported.ClientState) {
+	store := k.ClientStore(ctx, clientState.GetID())
+	bz := k.cdc.MustMarshalBinaryBare(clientState)
+	store.Set(types.KeyClientState(), bz)
+}
+
+// Consensus states are not stored through the Keeper: each light-client
+// module encodes and keys its own consensus state history however suits its
+// type (see, e.g., x/ibc/07-tendermint/store.go), reading and writing only
+// through the prefix store ClientStore hands it. The Keeper never reads a
+// consensus state itself, so it has no need for a generic accessor here.
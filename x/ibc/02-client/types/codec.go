@@ -0,0 +1,26 @@
+package types
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+// UnmarshalClientMessageHex decodes and unmarshals a ClientMessage from the
+// hex string carried by an AttributeKeyHeader event attribute, letting a
+// relayer round-trip the header or misbehaviour an UpdateClient event
+// reported without re-querying the original tx.
+func UnmarshalClientMessageHex(cdc codec.BinaryMarshaler, hexBz string) (exported.ClientMessage, error) {
+	bz, err := hex.DecodeString(hexBz)
+	if err != nil {
+		return nil, err
+	}
+
+	var clientMsg exported.ClientMessage
+	if err := cdc.UnmarshalBinaryBare(bz, &clientMsg); err != nil {
+		return nil, err
+	}
+
+	return clientMsg, nil
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC client sentinel errors
+var (
+	ErrClientExists           = sdkerrors.Register(SubModuleName, 2, "light client already exists")
+	ErrInvalidClient          = sdkerrors.Register(SubModuleName, 3, "light client is invalid")
+	ErrClientNotFound         = sdkerrors.Register(SubModuleName, 4, "light client not found")
+	ErrClientFrozen           = sdkerrors.Register(SubModuleName, 5, "light client is frozen due to misbehaviour")
+	ErrInvalidClientMetadata  = sdkerrors.Register(SubModuleName, 6, "invalid client metadata")
+	ErrConsensusStateNotFound = sdkerrors.Register(SubModuleName, 7, "consensus state not found")
+	ErrInvalidConsensus       = sdkerrors.Register(SubModuleName, 8, "invalid consensus state")
+	ErrClientTypeNotFound     = sdkerrors.Register(SubModuleName, 9, "client type not found")
+	ErrInvalidClientType      = sdkerrors.Register(SubModuleName, 10, "invalid client type")
+	ErrInvalidClientHeader    = sdkerrors.Register(SubModuleName, 11, "invalid client header")
+)
@@ -0,0 +1,33 @@
+package types
+
+// IBC client events
+const (
+	AttributeValueCategory = SubModuleName
+
+	EventTypeCreateClient       = "create_client"
+	EventTypeUpdateClient       = "update_client"
+	EventTypeSubmitMisbehaviour = "submit_misbehaviour"
+
+	AttributeKeyClientID   = "client_id"
+	AttributeKeyClientType = "client_type"
+
+	// AttributeKeyConsensusHeight holds the first height UpdateState wrote a
+	// consensus state at, kept for backward compatibility with clients that
+	// only expect a single height per update.
+	AttributeKeyConsensusHeight = "consensus_height"
+	// AttributeKeyConsensusHeights holds every height UpdateState wrote a
+	// consensus state at, comma-separated, to support updates that advance
+	// a client to more than one height in a single message.
+	AttributeKeyConsensusHeights = "consensus_heights"
+
+	// AttributeKeyHeader holds the hex-encoded, marshaled ClientMessage the
+	// update or misbehaviour submission carried, so that relayers and
+	// indexers can reconstruct it from the event stream alone. Decode it
+	// with UnmarshalClientMessageHex.
+	AttributeKeyHeader = "header"
+
+	// AttributeKeyAlreadyUpdated is set on an EventTypeUpdateClient event
+	// when the exact same consensus state was already stored at the
+	// header's height, so UpdateClient made no state change.
+	AttributeKeyAlreadyUpdated = "already-updated"
+)
@@ -0,0 +1,24 @@
+package types
+
+import "fmt"
+
+const (
+	// SubModuleName defines the IBC client name
+	SubModuleName = "client"
+)
+
+// KeyClientType returns the store key under which a client's type is stored
+func KeyClientType(clientID string) []byte {
+	return []byte(fmt.Sprintf("clients/%s/clientType", clientID))
+}
+
+// KeyClientState returns the store key, relative to a client's own prefix
+// store, under which its ClientState is stored
+//
+// There is no equivalent KeyConsensusState: each light-client module keys
+// and encodes its own consensus state history however suits its type (see,
+// e.g., x/ibc/07-tendermint/store.go), since the Keeper never reads one
+// itself.
+func KeyClientState() []byte {
+	return []byte("clientState")
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+// RouterKey is the message route for the IBC client submodule, used to look
+// up its handler in the root IBC module's tx router.
+const RouterKey = SubModuleName
+
+// msg types for the IBC client submodule
+const (
+	TypeMsgUpdateClient = "update_client"
+)
+
+var _ sdk.Msg = &MsgUpdateClient{}
+
+// MsgUpdateClient defines a message to update an IBC client, carrying
+// either a Header to advance it or a Misbehaviour proving a protocol
+// violation: both are ClientMessage, so the same message type and the same
+// Keeper.UpdateClient entry point handle them without the caller needing to
+// know in advance which kind it is submitting.
+type MsgUpdateClient struct {
+	ClientID      string         `json:"client_id" yaml:"client_id"`
+	ClientMessage *cdctypes.Any  `json:"client_message" yaml:"client_message"`
+	Signer        sdk.AccAddress `json:"signer" yaml:"signer"`
+}
+
+// NewMsgUpdateClient creates a new MsgUpdateClient instance, packing the
+// given ClientMessage (a Header or a Misbehaviour) into an Any.
+func NewMsgUpdateClient(clientID string, clientMsg exported.ClientMessage, signer sdk.AccAddress) (*MsgUpdateClient, error) {
+	anyMsg, err := cdctypes.NewAnyWithValue(clientMsg.(proto.Message))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to pack client message into Any")
+	}
+
+	return &MsgUpdateClient{
+		ClientID:      clientID,
+		ClientMessage: anyMsg,
+		Signer:        signer,
+	}, nil
+}
+
+// NewMsgSubmitMisbehaviour creates a MsgUpdateClient carrying a Misbehaviour
+// as its ClientMessage.
+//
+// Deprecated: construct a MsgUpdateClient directly with the Misbehaviour as
+// its ClientMessage. MsgSubmitMisbehaviour no longer exists as its own
+// message type; this constructor is kept only so callers built against the
+// old two-message API keep working.
+func NewMsgSubmitMisbehaviour(clientID string, misbehaviour exported.Misbehaviour, signer sdk.AccAddress) (*MsgUpdateClient, error) {
+	return NewMsgUpdateClient(clientID, misbehaviour, signer)
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateClient) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgUpdateClient) Type() string {
+	return TypeMsgUpdateClient
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateClient) ValidateBasic() error {
+	if msg.ClientID == "" {
+		return sdkerrors.Wrap(ErrInvalidClient, "client id cannot be empty")
+	}
+	if msg.ClientMessage == nil {
+		return sdkerrors.Wrap(ErrInvalidClientHeader, "client message cannot be nil")
+	}
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer address cannot be empty")
+	}
+
+	clientMsg, ok := msg.ClientMessage.GetCachedValue().(exported.ClientMessage)
+	if !ok {
+		return sdkerrors.Wrap(ErrInvalidClientHeader, "client message is not a ClientMessage")
+	}
+
+	return clientMsg.ValidateBasic()
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateClient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// GetClientMessage unpacks the ClientMessage carried in the Any, returning
+// an error if it was never registered with the interface registry.
+func (msg MsgUpdateClient) GetClientMessage() (exported.ClientMessage, error) {
+	clientMsg, ok := msg.ClientMessage.GetCachedValue().(exported.ClientMessage)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidClientHeader, "failed to unpack client message")
+	}
+
+	return clientMsg, nil
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage, allowing
+// the Any-packed ClientMessage to be resolved to its concrete type after
+// being unmarshaled from a tx.
+func (msg MsgUpdateClient) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var clientMsg exported.ClientMessage
+	return unpacker.UnpackAny(msg.ClientMessage, &clientMsg)
+}
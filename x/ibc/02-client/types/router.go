@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+// ClientModule is implemented by each light-client module (Tendermint,
+// solo-machine, localhost, ...) and registered against its ClientType on a
+// ClientRouter so that Keeper.CreateClient can confirm a module exists for
+// a submitted ClientState's type before creating it. Unlike Update, client
+// creation has no way to dispatch type-specific construction logic through
+// the router: the caller already constructs the concrete, fully-populated
+// ClientState itself (it alone knows its type-specific fields, such as a
+// Tendermint client's trusting period), so ClientModule only needs to
+// register that concrete type with the codec.
+type ClientModule interface {
+	// RegisterInterfaces registers this module's concrete ClientState,
+	// Header and Misbehaviour implementations with the codec so they can be
+	// marshaled and unmarshaled as exported.ClientState/ClientMessage.
+	RegisterInterfaces(registry cdctypes.InterfaceRegistry)
+}
+
+// ClientRouter maps a light client's ClientType to the ClientModule that
+// implements it, mirroring baseapp's Router. Light-client modules register
+// their own route at app wiring time and the keeper never branches on
+// client type again.
+type ClientRouter struct {
+	routes map[exported.ClientType]ClientModule
+	sealed bool
+}
+
+// NewClientRouter creates a new, empty ClientRouter
+func NewClientRouter() *ClientRouter {
+	return &ClientRouter{
+		routes: make(map[exported.ClientType]ClientModule),
+	}
+}
+
+// Seal prevents further routes from being added. It must be called once app
+// wiring has registered every light-client module, and before the router is
+// handed to NewKeeper.
+func (rtr *ClientRouter) Seal() {
+	if rtr.sealed {
+		panic("ClientRouter already sealed")
+	}
+	rtr.sealed = true
+}
+
+// AddRoute registers a ClientModule against a ClientType. It panics if the
+// router is already sealed or the route is already taken, matching the
+// fail-fast behaviour of sdk.Router.
+func (rtr *ClientRouter) AddRoute(clientType exported.ClientType, module ClientModule) *ClientRouter {
+	if rtr.sealed {
+		panic("cannot add route to sealed ClientRouter")
+	}
+	if rtr.HasRoute(clientType) {
+		panic(fmt.Sprintf("route for client type %s has already been registered", clientType))
+	}
+
+	rtr.routes[clientType] = module
+	return rtr
+}
+
+// HasRoute returns true if a ClientModule has been registered for the type
+func (rtr *ClientRouter) HasRoute(clientType exported.ClientType) bool {
+	_, ok := rtr.routes[clientType]
+	return ok
+}
+
+// GetRoute returns the ClientModule registered for the type, if any
+func (rtr *ClientRouter) GetRoute(clientType exported.ClientType) (ClientModule, bool) {
+	module, ok := rtr.routes[clientType]
+	return module, ok
+}
@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// mockClientModule is a no-op ClientModule used only to exercise
+// ClientRouter's registration behaviour without depending on a concrete
+// light-client implementation.
+type mockClientModule struct{}
+
+func (mockClientModule) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {}
+
+func TestClientRouter_AddRouteAndGetRoute(t *testing.T) {
+	rtr := types.NewClientRouter()
+	module := mockClientModule{}
+
+	require.False(t, rtr.HasRoute(exported.Tendermint))
+
+	rtr.AddRoute(exported.Tendermint, module)
+
+	require.True(t, rtr.HasRoute(exported.Tendermint))
+	got, found := rtr.GetRoute(exported.Tendermint)
+	require.True(t, found)
+	require.Equal(t, module, got)
+}
+
+func TestClientRouter_AddRouteTwicePanics(t *testing.T) {
+	rtr := types.NewClientRouter()
+	rtr.AddRoute(exported.Tendermint, mockClientModule{})
+
+	require.Panics(t, func() {
+		rtr.AddRoute(exported.Tendermint, mockClientModule{})
+	})
+}
+
+func TestClientRouter_SealedRouterRejectsAddRoute(t *testing.T) {
+	rtr := types.NewClientRouter()
+	rtr.Seal()
+
+	require.Panics(t, func() {
+		rtr.AddRoute(exported.Tendermint, mockClientModule{})
+	})
+}
@@ -0,0 +1,91 @@
+package tendermint
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+var _ clientexported.ClientState = ClientState{}
+
+// ClientState of a Tendermint light client tracks the current validator set,
+// trusting period, and frozen status of the counterparty chain.
+type ClientState struct {
+	ID              string                `json:"id" yaml:"id"`
+	ChainID         string                `json:"chain_id" yaml:"chain_id"`
+	TrustingPeriod  time.Duration         `json:"trusting_period" yaml:"trusting_period"`
+	UnbondingPeriod time.Duration         `json:"unbonding_period" yaml:"unbonding_period"`
+	MaxClockDrift   time.Duration         `json:"max_clock_drift" yaml:"max_clock_drift"`
+	FrozenHeight    clientexported.Height `json:"frozen_height" yaml:"frozen_height"`
+	LatestHeight    clientexported.Height `json:"latest_height" yaml:"latest_height"`
+}
+
+// NewClientState creates a new ClientState instance
+func NewClientState(
+	id, chainID string, trustingPeriod, unbondingPeriod, maxClockDrift time.Duration,
+	latestHeight clientexported.Height,
+) ClientState {
+	return ClientState{
+		ID:              id,
+		ChainID:         chainID,
+		TrustingPeriod:  trustingPeriod,
+		UnbondingPeriod: unbondingPeriod,
+		MaxClockDrift:   maxClockDrift,
+		LatestHeight:    latestHeight,
+	}
+}
+
+// ClientType is tendermint
+func (cs ClientState) ClientType() clientexported.ClientType {
+	return clientexported.Tendermint
+}
+
+// GetID returns the client identifier this state was created with
+func (cs ClientState) GetID() string {
+	return cs.ID
+}
+
+// GetLatestHeight returns the latest height the client was updated to
+func (cs ClientState) GetLatestHeight() clientexported.Height {
+	return cs.LatestHeight
+}
+
+// IsFrozen returns true if the frozen height has been set
+func (cs ClientState) IsFrozen() bool {
+	return cs.FrozenHeight != 0
+}
+
+// Validate performs basic validation of the client state fields
+func (cs ClientState) Validate() error {
+	if cs.ChainID == "" {
+		return sdkerrors.Wrap(ErrInvalidChainID, "chain id cannot be empty")
+	}
+	if cs.TrustingPeriod == 0 {
+		return sdkerrors.Wrap(ErrInvalidTrustingPeriod, "trusting period cannot be zero")
+	}
+	if cs.TrustingPeriod >= cs.UnbondingPeriod {
+		return sdkerrors.Wrap(ErrInvalidTrustingPeriod, "trusting period should be < unbonding period")
+	}
+	return nil
+}
+
+// Initialize checks that the consensus state supplied at client creation is
+// a Tendermint ConsensusState and stores it at the client's LatestHeight.
+// cs is expected to already be valid (the caller constructed it with
+// NewClientState and Keeper.CreateClient calls Validate beforehand).
+func (cs ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, consState clientexported.ConsensusState) error {
+	tmConsState, ok := consState.(ConsensusState)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidHeader, "expected type %T, got %T", ConsensusState{}, consState)
+	}
+
+	if err := tmConsState.ValidateBasic(); err != nil {
+		return err
+	}
+
+	setConsensusState(clientStore, cdc, cs.LatestHeight, tmConsState)
+	return nil
+}
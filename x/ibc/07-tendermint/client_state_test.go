@@ -0,0 +1,31 @@
+package tendermint_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tendermint "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint"
+)
+
+// TestNewClientState_ValidatesOK is a regression test for a bug where client
+// creation built a ClientState with an empty chain-id and zero trusting/
+// unbonding periods regardless of the caller's input, which always failed
+// its own Validate(). NewClientState must be given, and must retain, real
+// values for every field Validate() checks.
+func TestNewClientState_ValidatesOK(t *testing.T) {
+	cs := tendermint.NewClientState(
+		"tendermint-0", "test-chain", 2*time.Hour, 3*time.Hour, 10*time.Second, 10,
+	)
+
+	require.NoError(t, cs.Validate())
+}
+
+func TestNewClientState_EmptyChainIDFailsValidate(t *testing.T) {
+	cs := tendermint.NewClientState(
+		"tendermint-0", "", 2*time.Hour, 3*time.Hour, 10*time.Second, 10,
+	)
+
+	require.Error(t, cs.Validate())
+}
@@ -0,0 +1,45 @@
+package tendermint
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+var _ clientexported.ConsensusState = ConsensusState{}
+
+// ConsensusState defines the consensus state from a Tendermint chain,
+// derived from the header submitted at a given height.
+type ConsensusState struct {
+	Timestamp          uint64 `json:"timestamp" yaml:"timestamp"`
+	Root               []byte `json:"root" yaml:"root"`
+	NextValidatorsHash []byte `json:"next_validators_hash" yaml:"next_validators_hash"`
+}
+
+// ClientType is tendermint
+func (ConsensusState) ClientType() clientexported.ClientType {
+	return clientexported.Tendermint
+}
+
+// GetRoot returns the commitment root of the consensus state
+func (cs ConsensusState) GetRoot() []byte {
+	return cs.Root
+}
+
+// GetTimestamp returns the timestamp (in nanoseconds) of the consensus state
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return cs.Timestamp
+}
+
+// ValidateBasic defines basic validation for the tendermint consensus state
+func (cs ConsensusState) ValidateBasic() error {
+	if len(cs.Root) == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "root cannot be empty")
+	}
+	if len(cs.NextValidatorsHash) == 0 {
+		return sdkerrors.Wrap(ErrInvalidValidatorSet, "next validators hash cannot be empty")
+	}
+	if cs.Timestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "timestamp cannot be zero")
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package tendermint
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Tendermint client sentinel errors
+var (
+	ErrInvalidChainID        = sdkerrors.Register(SubModuleName, 2, "invalid chain-id")
+	ErrInvalidTrustingPeriod = sdkerrors.Register(SubModuleName, 3, "invalid trusting period")
+	ErrInvalidHeaderHeight   = sdkerrors.Register(SubModuleName, 4, "invalid header height")
+	ErrInvalidHeader         = sdkerrors.Register(SubModuleName, 5, "invalid header")
+	ErrInvalidValidatorSet   = sdkerrors.Register(SubModuleName, 6, "invalid validator set")
+	ErrInvalidEvidence       = sdkerrors.Register(SubModuleName, 7, "invalid evidence")
+)
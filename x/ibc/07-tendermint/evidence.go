@@ -0,0 +1,71 @@
+package tendermint
+
+import (
+	"bytes"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+var (
+	_ clientexported.Misbehaviour  = Evidence{}
+	_ clientexported.ClientMessage = Evidence{}
+)
+
+// Evidence defines misbehaviour for a Tendermint client: two headers for the
+// same height, each individually valid against the trusted validator set,
+// that disagree on the resulting application state. Submitting it through
+// Keeper.UpdateClient freezes the client.
+type Evidence struct {
+	ClientID string `json:"client_id" yaml:"client_id"`
+	Header1  Header `json:"header_1" yaml:"header_1"`
+	Header2  Header `json:"header_2" yaml:"header_2"`
+}
+
+// ClientType is tendermint
+func (ev Evidence) ClientType() clientexported.ClientType {
+	return clientexported.Tendermint
+}
+
+// GetClientID returns the ID of the client that is frozen by the evidence
+func (ev Evidence) GetClientID() string {
+	return ev.ClientID
+}
+
+// GetHeight returns the common height at which the headers conflict
+func (ev Evidence) GetHeight() clientexported.Height {
+	return ev.Header1.GetHeight()
+}
+
+// ValidateBasic checks that both headers are individually well formed, that
+// they do in fact share a height, and that they actually disagree on the
+// resulting state: two identical headers prove nothing, and submitting the
+// same valid header twice as "conflicting" must not be accepted as
+// misbehaviour.
+func (ev Evidence) ValidateBasic() error {
+	if ev.Header1.GetHeight() != ev.Header2.GetHeight() {
+		return sdkerrors.Wrap(ErrInvalidHeader, "misbehaviour headers must have the same height")
+	}
+
+	if err := ev.Header1.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "header 1 failed validation")
+	}
+
+	if err := ev.Header2.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "header 2 failed validation")
+	}
+
+	if !headersConflict(ev.Header1, ev.Header2) {
+		return sdkerrors.Wrap(ErrInvalidEvidence, "headers at the same height must disagree on app hash or validator set to prove misbehaviour")
+	}
+
+	return nil
+}
+
+// headersConflict reports whether two headers for the same height disagree
+// on the resulting application state, i.e. whether they are actual proof of
+// a fork rather than the same header submitted twice.
+func headersConflict(h1, h2 Header) bool {
+	return !bytes.Equal(h1.SignedHeader.AppHash, h2.SignedHeader.AppHash) ||
+		!bytes.Equal(h1.ValidatorSet.Hash(), h2.ValidatorSet.Hash())
+}
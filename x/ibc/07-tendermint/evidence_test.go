@@ -0,0 +1,30 @@
+package tendermint
+
+import (
+	"testing"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestHeadersConflict_IdenticalHeadersDoNotConflict is a regression test for
+// a DoS where Evidence{Header1: h, Header2: h} (the same valid header
+// submitted twice) was accepted as misbehaviour, since nothing checked that
+// the two headers actually disagreed before CheckForMisbehaviour froze the
+// client unconditionally for any Evidence value.
+func TestHeadersConflict_IdenticalHeadersDoNotConflict(t *testing.T) {
+	h := Header{
+		SignedHeader: tmtypes.SignedHeader{Header: &tmtypes.Header{AppHash: []byte("app-hash")}},
+		ValidatorSet: tmtypes.ValidatorSet{},
+	}
+
+	require.False(t, headersConflict(h, h))
+}
+
+func TestHeadersConflict_DifferentAppHashConflicts(t *testing.T) {
+	h1 := Header{SignedHeader: tmtypes.SignedHeader{Header: &tmtypes.Header{AppHash: []byte("app-hash-1")}}}
+	h2 := Header{SignedHeader: tmtypes.SignedHeader{Header: &tmtypes.Header{AppHash: []byte("app-hash-2")}}}
+
+	require.True(t, headersConflict(h1, h2))
+}
@@ -0,0 +1,55 @@
+package tendermint
+
+import (
+	"bytes"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+var _ clientexported.Header = Header{}
+
+// Header defines the Tendermint consensus state update injected into a
+// client by way of MsgUpdateClient. It carries the signed header together
+// with the validator set that produced it, plus the height the client
+// already trusts and the validator set stored for that height, so that the
+// light client can verify the commit against what it already trusts.
+type Header struct {
+	SignedHeader     tmtypes.SignedHeader `json:"signed_header" yaml:"signed_header"`
+	ValidatorSet     tmtypes.ValidatorSet `json:"validator_set" yaml:"validator_set"`
+	TrustedHeight    clientexported.Height `json:"trusted_height" yaml:"trusted_height"`
+	TrustedValidators tmtypes.ValidatorSet `json:"trusted_validators" yaml:"trusted_validators"`
+}
+
+// ClientType is tendermint
+func (h Header) ClientType() clientexported.ClientType {
+	return clientexported.Tendermint
+}
+
+// GetHeight returns the current height of the header
+func (h Header) GetHeight() clientexported.Height {
+	return clientexported.Height(h.SignedHeader.Height)
+}
+
+// ValidateBasic calls the SignedHeader ValidateBasic function, checks that
+// the validator set is non-empty and hashes to the validators hash committed
+// on the header, and checks that the trusted validator set is non-empty. The
+// trusted validator set is matched against the consensus state stored at
+// TrustedHeight by ClientState.VerifyClientMessage, not here.
+func (h Header) ValidateBasic() error {
+	if err := h.SignedHeader.ValidateBasic(h.SignedHeader.ChainID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidHeader, err.Error())
+	}
+	if h.ValidatorSet.Hash() == nil {
+		return sdkerrors.Wrap(ErrInvalidValidatorSet, "validator set is empty")
+	}
+	if !bytes.Equal(h.ValidatorSet.Hash(), h.SignedHeader.ValidatorsHash) {
+		return sdkerrors.Wrap(ErrInvalidValidatorSet, "validator set does not match the header's validators hash")
+	}
+	if h.TrustedValidators.Hash() == nil {
+		return sdkerrors.Wrap(ErrInvalidValidatorSet, "trusted validator set is empty")
+	}
+	return nil
+}
@@ -0,0 +1,6 @@
+package tendermint
+
+const (
+	// SubModuleName defines the 07-tendermint light client name
+	SubModuleName = "client-tendermint"
+)
@@ -0,0 +1,38 @@
+package tendermint
+
+import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+var _ clienttypes.ClientModule = ClientModule{}
+
+// ClientModule implements clienttypes.ClientModule for the Tendermint light
+// client. It is registered against clientexported.Tendermint on a
+// clienttypes.ClientRouter at app wiring time. Client creation itself goes
+// through ClientState.Initialize, not through this module: a tendermint
+// ClientState is constructed client-side with its real chain-id, trusting
+// period and unbonding period (via NewClientState) before ever reaching
+// Keeper.CreateClient.
+type ClientModule struct{}
+
+// NewClientModule creates a new Tendermint ClientModule
+func NewClientModule() ClientModule {
+	return ClientModule{}
+}
+
+// RegisterInterfaces registers the Tendermint ClientState, Header and
+// Evidence implementations against the exported ClientState/ClientMessage
+// interfaces so that they can be marshaled and unmarshaled as Any.
+func (ClientModule) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*clientexported.ClientState)(nil),
+		&ClientState{},
+	)
+	registry.RegisterImplementations(
+		(*clientexported.ClientMessage)(nil),
+		&Header{},
+		&Evidence{},
+	)
+}
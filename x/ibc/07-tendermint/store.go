@@ -0,0 +1,35 @@
+package tendermint
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+// consensusStateKey returns the key under which a consensus state for the
+// given height is stored in the client's prefix store.
+func consensusStateKey(height clientexported.Height) []byte {
+	return []byte(fmt.Sprintf("consensusStates/%d", height))
+}
+
+// GetConsensusState retrieves the consensus state stored at the given height
+// from the client's prefix store.
+func GetConsensusState(clientStore sdk.KVStore, cdc codec.BinaryMarshaler, height clientexported.Height) (ConsensusState, bool) {
+	bz := clientStore.Get(consensusStateKey(height))
+	if bz == nil {
+		return ConsensusState{}, false
+	}
+
+	var consState ConsensusState
+	cdc.MustUnmarshalBinaryBare(bz, &consState)
+	return consState, true
+}
+
+// setConsensusState writes the consensus state at the given height to the
+// client's prefix store.
+func setConsensusState(clientStore sdk.KVStore, cdc codec.BinaryMarshaler, height clientexported.Height, consState ConsensusState) {
+	bz := cdc.MustMarshalBinaryBare(&consState)
+	clientStore.Set(consensusStateKey(height), bz)
+}
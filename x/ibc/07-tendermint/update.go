@@ -0,0 +1,156 @@
+package tendermint
+
+import (
+	"bytes"
+
+	tmmath "github.com/tendermint/tendermint/libs/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+// VerifyClientMessage checks that the supplied ClientMessage is well formed
+// and, in the Header case, signed by 1/3+ of the trusted validator set and
+// chronologically ahead of the client's currently trusted height. In the
+// Evidence case it verifies that both conflicting headers are individually
+// valid. It does not mutate the client store.
+func (cs ClientState) VerifyClientMessage(
+	ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, clientMsg clientexported.ClientMessage,
+) error {
+	switch msg := clientMsg.(type) {
+	case Header:
+		return cs.verifyHeader(ctx, cdc, clientStore, msg)
+	case Evidence:
+		return cs.verifyEvidence(ctx, cdc, clientStore, msg)
+	default:
+		return sdkerrors.Wrapf(ErrInvalidHeader, "unsupported client message type %T", clientMsg)
+	}
+}
+
+func (cs ClientState) verifyHeader(ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, header Header) error {
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if header.SignedHeader.ChainID != cs.ChainID {
+		return sdkerrors.Wrapf(ErrInvalidChainID, "expected chain-id %s, got %s", cs.ChainID, header.SignedHeader.ChainID)
+	}
+
+	trustedConsState, found := GetConsensusState(clientStore, cdc, header.TrustedHeight)
+	if !found {
+		return sdkerrors.Wrapf(ErrInvalidHeader, "could not find consensus state at trusted height %d", header.TrustedHeight)
+	}
+
+	if !bytes.Equal(trustedConsState.NextValidatorsHash, header.TrustedValidators.Hash()) {
+		return sdkerrors.Wrap(ErrInvalidValidatorSet, "trusted validators do not match those stored at the trusted height")
+	}
+
+	if header.GetHeight() <= header.TrustedHeight {
+		return sdkerrors.Wrapf(ErrInvalidHeaderHeight, "header height %d must be greater than trusted height %d", header.GetHeight(), header.TrustedHeight)
+	}
+
+	if err := header.TrustedValidators.VerifyCommitLightTrusting(
+		cs.ChainID, header.SignedHeader.Commit, tmmath.Fraction{Numerator: 1, Denominator: 3},
+	); err != nil {
+		return sdkerrors.Wrap(err, "header commit is not signed by 1/3+ of the trusted validator set")
+	}
+
+	return header.ValidatorSet.VerifyCommitLight(
+		cs.ChainID, header.SignedHeader.Commit.BlockID, header.SignedHeader.Height, header.SignedHeader.Commit,
+	)
+}
+
+func (cs ClientState) verifyEvidence(ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, evidence Evidence) error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := cs.verifyHeader(ctx, cdc, clientStore, evidence.Header1); err != nil {
+		return sdkerrors.Wrap(err, "header 1 failed verification")
+	}
+
+	return cs.verifyHeader(ctx, cdc, clientStore, evidence.Header2)
+}
+
+// CheckForMisbehaviour reports evidence submissions as misbehaviour
+// unconditionally, since they are explicit proof of a protocol violation.
+// For a header, it checks whether the header conflicts with a consensus
+// state already stored for its height, which is proof of a fork.
+func (cs ClientState) CheckForMisbehaviour(
+	ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, clientMsg clientexported.ClientMessage,
+) bool {
+	switch msg := clientMsg.(type) {
+	case Evidence:
+		return true
+	case Header:
+		existing, found := GetConsensusState(clientStore, cdc, msg.GetHeight())
+		if !found {
+			return false
+		}
+
+		return existing.Timestamp != uint64(msg.SignedHeader.Time.UnixNano()) ||
+			!bytes.Equal(existing.Root, msg.SignedHeader.AppHash) ||
+			!bytes.Equal(existing.NextValidatorsHash, msg.ValidatorSet.Hash())
+	default:
+		return false
+	}
+}
+
+// UpdateStateOnMisbehaviour freezes the client at the height misbehaviour
+// was detected so that no further updates or packet proofs are accepted. It
+// returns the frozen ClientState rather than persisting it itself: cs has a
+// value receiver, so the caller must write the returned value back with
+// Keeper.SetClientState.
+func (cs ClientState) UpdateStateOnMisbehaviour(
+	ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, clientMsg clientexported.ClientMessage,
+) clientexported.ClientState {
+	cs.FrozenHeight = cs.LatestHeight
+	return cs
+}
+
+// UpdateState stores the consensus state carried by the header and advances
+// the client's latest height. It returns the height the new consensus state
+// was written at so the caller can emit it. It is never called with
+// Evidence, since CheckForMisbehaviour always short-circuits Keeper.UpdateClient
+// into the misbehaviour path for evidence submissions.
+//
+// If a consensus state is already stored at the header's height, it can
+// only be byte-identical to the one this header would produce: anything
+// else would already have been caught as misbehaviour by CheckForMisbehaviour
+// and routed away from UpdateState. In that case UpdateState is a no-op and
+// returns no heights, so a relayer resubmitting the same header twice (a
+// common race) does not pay for a redundant write.
+//
+// UpdateState returns the updated ClientState alongside the written heights
+// rather than persisting it itself: cs has a value receiver, so the caller
+// must write the returned value back with Keeper.SetClientState.
+func (cs ClientState) UpdateState(
+	ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, clientMsg clientexported.ClientMessage,
+) (clientexported.ClientState, []clientexported.Height) {
+	header, ok := clientMsg.(Header)
+	if !ok {
+		return cs, nil
+	}
+
+	height := header.GetHeight()
+
+	if _, found := GetConsensusState(clientStore, cdc, height); found {
+		return cs, nil
+	}
+
+	consensusState := ConsensusState{
+		Timestamp:          uint64(header.SignedHeader.Time.UnixNano()),
+		Root:               header.SignedHeader.AppHash,
+		NextValidatorsHash: header.ValidatorSet.Hash(),
+	}
+
+	setConsensusState(clientStore, cdc, height, consensusState)
+
+	if height > cs.LatestHeight {
+		cs.LatestHeight = height
+	}
+
+	return cs, []clientexported.Height{height}
+}
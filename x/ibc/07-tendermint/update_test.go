@@ -0,0 +1,73 @@
+package tendermint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	tendermint "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint"
+)
+
+// TestUpdateStateOnMisbehaviour_ReturnsFrozenState is a regression test for
+// a bug where the keeper re-persisted the pre-call ClientState after
+// invoking this hook: since ClientState methods have a value receiver, the
+// freeze set here never propagated back unless the caller used the
+// returned value. It asserts the contract the keeper now relies on: the
+// returned ClientState, not the receiver, carries the frozen height.
+func TestUpdateStateOnMisbehaviour_ReturnsFrozenState(t *testing.T) {
+	cs := tendermint.ClientState{ID: "tendermint-0", LatestHeight: 10}
+	require.False(t, cs.IsFrozen())
+
+	updated := cs.UpdateStateOnMisbehaviour(sdk.Context{}, nil, nil, nil)
+
+	require.False(t, cs.IsFrozen(), "receiver must not be mutated in place")
+	require.True(t, updated.IsFrozen())
+	require.Equal(t, clientexported.Height(10), updated.GetLatestHeight())
+}
+
+// TestUpdateState_NoOp_ReturnsNoHeights asserts that UpdateState reports no
+// written heights when the client message does not produce a new consensus
+// state (here, because it isn't a Header at all), so that
+// Keeper.UpdateClient can skip the SetClientState write on that path
+// instead of persisting an unchanged ClientState on every relayer resubmit.
+func TestUpdateState_NoOp_ReturnsNoHeights(t *testing.T) {
+	cs := tendermint.ClientState{ID: "tendermint-0", LatestHeight: 5}
+
+	updated, heights := cs.UpdateState(sdk.Context{}, nil, nil, nil)
+
+	require.Nil(t, heights)
+	require.Equal(t, cs, updated)
+}
+
+// TestUpdateState_DuplicateHeader_NoOp is a regression test for the
+// idempotent-duplicate-header path chunk0-6 added: a Header whose height
+// already has a consensus state stored must report no new heights rather
+// than being treated as an update (or, worse, as a conflict). The earlier
+// TestUpdateState_NoOp_ReturnsNoHeights only covers the unrelated
+// "clientMsg isn't a Header at all" branch and does not exercise this path.
+func TestUpdateState_DuplicateHeader_NoOp(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	clientStore := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	const height = clientexported.Height(5)
+	header := tendermint.Header{
+		SignedHeader: tmtypes.SignedHeader{Header: &tmtypes.Header{Height: int64(height)}},
+	}
+
+	cs := tendermint.ClientState{ID: "tendermint-0", LatestHeight: height}
+
+	// Seed the store as if this header had already been applied once.
+	_, heights := cs.UpdateState(sdk.Context{}, cdc, clientStore, header)
+	require.Equal(t, []clientexported.Height{height}, heights)
+
+	// Resubmitting the same header must be a no-op: no new heights, so the
+	// caller knows not to spend a SetClientState write on it.
+	_, heights = cs.UpdateState(sdk.Context{}, cdc, clientStore, header)
+	require.Nil(t, heights)
+}